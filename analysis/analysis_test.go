@@ -0,0 +1,83 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/joetifa2003/graph-go"
+	"github.com/joetifa2003/graph-go/analysis"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildPath() graph.Graph[int, string] {
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	g.AddUndirectedEdge(1, 2, nil)
+	g.AddUndirectedEdge(2, 3, nil)
+
+	return g
+}
+
+func TestBetweennessCentrality(t *testing.T) {
+	assert := assert.New(t)
+
+	g := buildPath()
+	cb := analysis.BetweennessCentrality(&g, false)
+
+	assert.Equal(0.0, cb[1])
+	assert.True(cb[2] > 0)
+	assert.Equal(0.0, cb[3])
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	assert := assert.New(t)
+
+	g := buildPath()
+	cc := analysis.ClosenessCentrality(&g)
+
+	assert.True(cc[2] > cc[1])
+}
+
+func TestPageRank(t *testing.T) {
+	assert := assert.New(t)
+
+	g := buildPath()
+	pr := analysis.PageRank(&g, 0.85, 0.0001)
+
+	sum := pr[1] + pr[2] + pr[3]
+	assert.InDelta(1.0, sum, 0.01)
+}
+
+func TestPageRankDirectedWithSink(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	assert.Nil(g.AddEdge(1, 2, nil))
+	assert.Nil(g.AddEdge(2, 3, nil)) // 3 is a sink: no outbound edges
+
+	pr := analysis.PageRank(&g, 0.85, 0.0001)
+
+	sum := pr[1] + pr[2] + pr[3]
+	assert.InDelta(1.0, sum, 0.01)
+}
+
+func TestCommunities(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	g.SetNode(4, "Node 4")
+	g.AddUndirectedEdge(1, 2, nil)
+	g.AddUndirectedEdge(3, 4, nil)
+
+	communities := analysis.Communities(&g)
+	assert.Equal(communities[1], communities[2])
+	assert.Equal(communities[3], communities[4])
+	assert.NotEqual(communities[1], communities[3])
+}
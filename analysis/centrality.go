@@ -0,0 +1,102 @@
+// Package analysis provides graph metrics (centrality, PageRank, community
+// detection) built on top of the graph.Graph adjacency and BFS primitives.
+package analysis
+
+import "github.com/joetifa2003/graph-go"
+
+// BetweennessCentrality computes, for every node, the fraction of
+// shortest paths between all other pairs of nodes that pass through it,
+// using Brandes' algorithm. Set directed to false to divide the result by 2,
+// matching the convention that an undirected edge is double-counted once per
+// direction.
+func BetweennessCentrality[K comparable, T any](g *graph.Graph[K, T], directed bool) map[K]float64 {
+	keys := g.GetNodeKeys(func(T) bool { return true })
+
+	cb := make(map[K]float64, len(keys))
+	for _, k := range keys {
+		cb[k] = 0
+	}
+
+	for _, s := range keys {
+		stack := make([]K, 0, len(keys))
+		pred := map[K][]K{}
+		sigma := map[K]float64{s: 1}
+		dist := map[K]int{s: 0}
+
+		queue := []K{s}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			stack = append(stack, v)
+
+			edges, _ := g.GetEdges(v)
+			for _, w := range edges {
+				if _, visited := dist[w]; !visited {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					pred[w] = append(pred[w], v)
+				}
+			}
+		}
+
+		delta := map[K]float64{}
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range pred[w] {
+				delta[v] += (sigma[v] / sigma[w]) * (1 + delta[w])
+			}
+			if w != s {
+				cb[w] += delta[w]
+			}
+		}
+	}
+
+	if !directed {
+		for k := range cb {
+			cb[k] /= 2
+		}
+	}
+
+	return cb
+}
+
+// ClosenessCentrality computes, for every node, the fraction of reachable
+// nodes divided by the sum of distances to them (Wasserman-Faust closeness,
+// which tolerates disconnected graphs).
+func ClosenessCentrality[K comparable, T any](g *graph.Graph[K, T]) map[K]float64 {
+	keys := g.GetNodeKeys(func(T) bool { return true })
+	result := make(map[K]float64, len(keys))
+
+	for _, s := range keys {
+		dist := map[K]int{s: 0}
+		queue := []K{s}
+		totalDist := 0
+		reached := 0
+
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+
+			edges, _ := g.GetEdges(v)
+			for _, w := range edges {
+				if _, visited := dist[w]; !visited {
+					dist[w] = dist[v] + 1
+					totalDist += dist[w]
+					reached++
+					queue = append(queue, w)
+				}
+			}
+		}
+
+		if totalDist > 0 {
+			result[s] = float64(reached) / float64(len(keys)-1) * float64(reached) / float64(totalDist)
+		} else {
+			result[s] = 0
+		}
+	}
+
+	return result
+}
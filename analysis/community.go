@@ -0,0 +1,67 @@
+package analysis
+
+import "github.com/joetifa2003/graph-go"
+
+// Communities assigns every node to a community id using the local-moving
+// phase of the Louvain method: nodes start in their own community and
+// repeatedly move to whichever neighboring community maximizes modularity
+// gain, until no move improves it.
+func Communities[K comparable, T any](g *graph.Graph[K, T]) map[K]int {
+	keys := g.GetNodeKeys(func(T) bool { return true })
+
+	community := make(map[K]int, len(keys))
+	degree := make(map[K]float64, len(keys))
+	neighbors := make(map[K][]K, len(keys))
+	m := 0.0
+
+	for i, k := range keys {
+		community[k] = i
+		edges, _ := g.GetEdges(k)
+		neighbors[k] = edges
+		degree[k] = float64(len(edges))
+		m += float64(len(edges))
+	}
+
+	if m == 0 {
+		return community
+	}
+
+	communityDegree := map[int]float64{}
+	for _, k := range keys {
+		communityDegree[community[k]] += degree[k]
+	}
+
+	for improved := true; improved; {
+		improved = false
+
+		for _, v := range keys {
+			currentComm := community[v]
+			communityDegree[currentComm] -= degree[v]
+
+			edgesToComm := map[int]float64{}
+			for _, u := range neighbors[v] {
+				edgesToComm[community[u]]++
+			}
+
+			bestComm := currentComm
+			bestGain := edgesToComm[currentComm] - degree[v]*communityDegree[currentComm]/m
+
+			for comm, edgesIn := range edgesToComm {
+				gain := edgesIn - degree[v]*communityDegree[comm]/m
+				if gain > bestGain {
+					bestGain = gain
+					bestComm = comm
+				}
+			}
+
+			community[v] = bestComm
+			communityDegree[bestComm] += degree[v]
+
+			if bestComm != currentComm {
+				improved = true
+			}
+		}
+	}
+
+	return community
+}
@@ -0,0 +1,75 @@
+package analysis
+
+import "github.com/joetifa2003/graph-go"
+
+// maxPageRankIterations caps the power iteration so a tol <= 0, or any
+// non-converging distribution, can't loop forever.
+const maxPageRankIterations = 100
+
+// PageRank computes the PageRank of every node via power iteration,
+// PR_{t+1}(v) = (1-damping)/N + damping * sum(PR_t(u)/outdeg(u)) over
+// in-neighbors u of v, stopping once the L1 delta between iterations drops
+// below tol or maxPageRankIterations is reached. Dangling nodes (outdeg 0)
+// have their rank redistributed uniformly over every node each iteration, so
+// the returned distribution always sums to ~1.
+func PageRank[K comparable, T any](g *graph.Graph[K, T], damping float64, tol float64) map[K]float64 {
+	keys := g.GetNodeKeys(func(T) bool { return true })
+	n := float64(len(keys))
+	if n == 0 {
+		return map[K]float64{}
+	}
+
+	outdeg := make(map[K]float64, len(keys))
+	inNeighbors := map[K][]K{}
+	for _, k := range keys {
+		edges, _ := g.GetEdges(k)
+		outdeg[k] = float64(len(edges))
+		for _, to := range edges {
+			inNeighbors[to] = append(inNeighbors[to], k)
+		}
+	}
+
+	pr := make(map[K]float64, len(keys))
+	for _, k := range keys {
+		pr[k] = 1 / n
+	}
+
+	for iter := 0; iter < maxPageRankIterations; iter++ {
+		danglingSum := 0.0
+		for _, k := range keys {
+			if outdeg[k] == 0 {
+				danglingSum += pr[k]
+			}
+		}
+
+		next := make(map[K]float64, len(keys))
+		delta := 0.0
+
+		for _, v := range keys {
+			sum := 0.0
+			for _, u := range inNeighbors[v] {
+				if outdeg[u] > 0 {
+					sum += pr[u] / outdeg[u]
+				}
+			}
+
+			next[v] = (1-damping)/n + damping*(sum+danglingSum/n)
+			delta += abs(next[v] - pr[v])
+		}
+
+		pr = next
+		if delta < tol {
+			break
+		}
+	}
+
+	return pr
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"context"
+	"sync"
+)
+
+// Snapshot returns a deep copy of the graph that is safe to read without
+// locking, since nothing else holds a reference to its internal maps.
+func (g *Graph[K, T]) Snapshot() Graph[K, T] {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	out := NewGraph[K, T]()
+
+	for key, value := range g.nodes {
+		out.nodes[key] = value
+	}
+
+	for key, edges := range g.edges {
+		out.edges[key] = append([]K{}, edges...)
+	}
+
+	for key, metas := range g.edgesMetaData {
+		copied := make(map[K]interface{}, len(metas))
+		for to, meta := range metas {
+			copied[to] = meta
+		}
+		out.edgesMetaData[key] = copied
+	}
+
+	for key, weights := range g.weights {
+		copied := make(map[K]float64, len(weights))
+		for to, w := range weights {
+			copied[to] = w
+		}
+		out.weights[key] = copied
+	}
+
+	return out
+}
+
+// WalkConcurrent partitions the graph's nodes across a pool of workers
+// workers wide and calls visit on each one concurrently, returning as soon
+// as any call to visit returns an error (further pending nodes are skipped)
+// or ctx is canceled.
+func (g *Graph[K, T]) WalkConcurrent(ctx context.Context, workers int, visit func(K) error) error {
+	g.mu.RLock()
+	keys := make([]K, 0, len(g.nodes))
+	for key := range g.nodes {
+		keys = append(keys, key)
+	}
+	g.mu.RUnlock()
+
+	if workers <= 0 {
+		workers = 1
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan K)
+
+	var once sync.Once
+	var firstErr error
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-workerCtx.Done():
+					return
+				case key, ok := <-jobs:
+					if !ok {
+						return
+					}
+
+					if err := visit(key); err != nil {
+						once.Do(func() { firstErr = err })
+						cancel()
+						return
+					}
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, key := range keys {
+		select {
+		case <-workerCtx.Done():
+			break feed
+		case jobs <- key:
+		}
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
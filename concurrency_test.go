@@ -0,0 +1,109 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/joetifa2003/graph-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	assert.Nil(g.AddEdge(1, 2, "meta"))
+
+	snap := g.Snapshot()
+	assert.Nil(snap.AddEdge(2, 1, nil)) // mutating the snapshot...
+
+	edges, err := g.GetEdges(2)
+	assert.Nil(err)
+	assert.Equal(0, len(edges)) // ...must not affect the original graph
+}
+
+func TestWalkConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	for i := 0; i < 10; i++ {
+		g.SetNode(i, "Node")
+	}
+
+	var visited int32
+	err := g.WalkConcurrent(context.Background(), 4, func(k int) error {
+		atomic.AddInt32(&visited, 1)
+		return nil
+	})
+
+	assert.Nil(err)
+	assert.Equal(int32(10), visited)
+}
+
+func TestWalkConcurrentStopsOnError(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	for i := 0; i < 10; i++ {
+		g.SetNode(i, "Node")
+	}
+
+	boom := errors.New("boom")
+	err := g.WalkConcurrent(context.Background(), 2, func(k int) error {
+		if k == 5 {
+			return boom
+		}
+		return nil
+	})
+
+	assert.Equal(boom, err)
+}
+
+func TestCopiedGraphSharesLockAndData(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, int]()
+	g2 := g // a plain value copy, not a Snapshot
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			g.SetNode(i, i)
+		}(i)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			g2.SetNode(i+50, i+50)
+		}(i)
+	}
+	wg.Wait()
+
+	keys := g.GetNodeKeys(func(int) bool { return true })
+	assert.Equal(100, len(keys)) // g and g2 must have mutated the same underlying maps
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	g := graph.NewGraph[int, int]()
+	for i := 0; i < 20; i++ {
+		g.SetNode(i, i)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			g.AddEdge(i, (i+1)%20, nil)
+			g.GetEdges(i)
+			g.ShortestPath(i, (i+1)%20)
+		}(i)
+	}
+	wg.Wait()
+}
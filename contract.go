@@ -0,0 +1,175 @@
+package graph
+
+// MergeNodes collapses keys into a single node newKey with value newValue,
+// redirecting every inbound and outbound edge of the merged nodes to newKey
+// and deduplicating the resulting parallel edges. When two merged nodes had
+// an edge between them, it becomes a self-loop on newKey if keepSelfLoops is
+// true, or is dropped otherwise. Whenever two edges collapse onto the same
+// target, their metadata is combined with merge.
+func (g *Graph[K, T]) MergeNodes(keys []K, newKey K, newValue T, merge func(a, b interface{}) interface{}, keepSelfLoops bool) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	merged := make(map[K]bool, len(keys))
+	for _, k := range keys {
+		if _, err := g.getNode(k); err != nil {
+			return err
+		}
+		merged[k] = true
+	}
+
+	// Snapshot the edges of the merged nodes before setNode/addEdge start
+	// mutating the graph, since newKey may itself be one of keys.
+	type redirectedEdge struct {
+		other K
+		meta  interface{}
+	}
+	// newKey doesn't have to be one of keys (merging into a brand new or an
+	// already-existing, unrelated node is valid), so its own prior outbound
+	// edges must be captured here too or the delete below would silently
+	// drop them.
+	sources := keys
+	if !merged[newKey] {
+		sources = append(append([]K{}, keys...), newKey)
+	}
+
+	outEdges := []redirectedEdge{}
+	for _, from := range sources {
+		for _, to := range g.edges[from] {
+			// Both endpoints map to newKey once the merge is applied: from
+			// because it's in sources (a merged key or newKey itself), and to
+			// because it's a merged key or is already newKey. Either way the
+			// edge becomes a self-loop on newKey, so it needs the same gate.
+			target := to
+			if merged[target] || target == newKey {
+				if !keepSelfLoops {
+					continue
+				}
+				target = newKey
+			}
+			outEdges = append(outEdges, redirectedEdge{other: target, meta: g.edgesMetaData[from][to]})
+		}
+	}
+
+	inEdges := []redirectedEdge{}
+	for from := range g.nodes {
+		if merged[from] || from == newKey {
+			// An edge from newKey into a merged key is a self-loop on newKey
+			// too; it was already captured (and gated) by the outbound loop
+			// above since newKey is included in sources.
+			continue
+		}
+		for _, k := range keys {
+			if edgeAlreadyPresent(g.edges[from], k) {
+				inEdges = append(inEdges, redirectedEdge{other: from, meta: g.edgesMetaData[from][k]})
+			}
+		}
+	}
+
+	for from := range g.nodes {
+		if merged[from] {
+			continue
+		}
+		for _, k := range keys {
+			if edgeAlreadyPresent(g.edges[from], k) {
+				g.removeEdge(from, k)
+			}
+		}
+	}
+
+	// Drop newKey's stale outbound edge slice; its edges (whether it was one
+	// of keys or not) were already captured into outEdges above and are
+	// rebuilt below.
+	delete(g.edges, newKey)
+	delete(g.edgesMetaData, newKey)
+
+	g.setNode(newKey, newValue)
+
+	mergedOutMeta := map[K]interface{}{}
+	for _, e := range outEdges {
+		if existing, ok := mergedOutMeta[e.other]; ok && existing != nil && e.meta != nil {
+			mergedOutMeta[e.other] = merge(existing, e.meta)
+		} else if !ok || e.meta != nil {
+			mergedOutMeta[e.other] = e.meta
+		}
+	}
+	for target, meta := range mergedOutMeta {
+		if err := g.addEdge(newKey, target, nil); err != nil {
+			return err
+		}
+		if meta != nil {
+			g.setMetaData(newKey, target, meta)
+		}
+	}
+
+	mergedInMeta := map[K]interface{}{}
+	for _, e := range inEdges {
+		if existing, ok := mergedInMeta[e.other]; ok && existing != nil && e.meta != nil {
+			mergedInMeta[e.other] = merge(existing, e.meta)
+		} else if !ok || e.meta != nil {
+			mergedInMeta[e.other] = e.meta
+		}
+	}
+	for from, meta := range mergedInMeta {
+		if err := g.addEdge(from, newKey, nil); err != nil {
+			return err
+		}
+		if meta != nil {
+			g.setMetaData(from, newKey, meta)
+		}
+	}
+
+	for _, k := range keys {
+		if k == newKey {
+			continue
+		}
+		delete(g.nodes, k)
+		delete(g.edges, k)
+		delete(g.edgesMetaData, k)
+	}
+
+	return nil
+}
+
+// Contract groups every node by groupBy(key, value) and merges each group
+// into a single node keyed by its group name, returning a new graph whose
+// node values collect the original values of every node that fell into that
+// group. Edges between two nodes in the same group are dropped; edges
+// between different groups are carried over with their metadata.
+//
+// Contract is a free function rather than a method because a method of
+// Graph[K, T] that instantiates Graph[string, []T] from within its own body
+// trips Go's generic instantiation-cycle check.
+func Contract[K comparable, T any](g *Graph[K, T], groupBy func(K, T) string) Graph[string, []T] {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	out := NewGraph[string, []T]()
+	groupOf := make(map[K]string, len(g.nodes))
+
+	for key, value := range g.nodes {
+		group := groupBy(key, value)
+		groupOf[key] = group
+
+		if existing, err := out.GetNode(group); err == nil {
+			out.SetNode(group, append(existing, value))
+		} else {
+			out.SetNode(group, []T{value})
+		}
+	}
+
+	for from, edges := range g.edges {
+		fromGroup := groupOf[from]
+
+		for _, to := range edges {
+			toGroup := groupOf[to]
+			if fromGroup == toGroup {
+				continue
+			}
+
+			out.AddEdge(fromGroup, toGroup, g.edgesMetaData[from][to])
+		}
+	}
+
+	return out
+}
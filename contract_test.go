@@ -0,0 +1,108 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/joetifa2003/graph-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func sumMerge(a, b interface{}) interface{} {
+	return a.(int) + b.(int)
+}
+
+func TestMergeNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	assert.Nil(g.AddEdge(1, 3, 1))
+	assert.Nil(g.AddEdge(2, 3, 2))
+	assert.Nil(g.AddEdge(3, 1, 5)) // inbound edge, redirected to the merged node
+
+	err := g.MergeNodes([]int{1, 2}, 1, "Merged", sumMerge, false)
+	assert.Nil(err)
+
+	node, err := g.GetNode(1)
+	assert.Nil(err)
+	assert.Equal("Merged", node)
+
+	edges, err := g.GetEdges(1)
+	assert.Nil(err)
+	assert.Equal(1, len(edges)) // 1->3 and 2->3 collapsed into one edge
+	assert.Equal(3, g.GetMetaData(1, 3))
+
+	_, err = g.GetNode(2)
+	assert.Error(err) // node 2 no longer exists
+}
+
+func TestMergeNodesIntoUnrelatedKey(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(5, "Node 5")
+	g.SetNode(6, "Node 6")
+	assert.Nil(g.AddEdge(5, 6, nil)) // newKey's own pre-existing edge
+
+	err := g.MergeNodes([]int{1, 2}, 5, "Merged", sumMerge, false)
+	assert.Nil(err)
+
+	edges, err := g.GetEdges(5)
+	assert.Nil(err)
+	assert.Equal([]int{6}, edges) // 5->6 must survive the merge
+}
+
+func TestMergeNodesSelfLoopWithUnrelatedNewKey(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(5, "Node 5")
+	assert.Nil(g.AddEdge(1, 5, nil)) // merged key -> unrelated newKey
+
+	err := g.MergeNodes([]int{1, 2}, 5, "Merged", sumMerge, false)
+	assert.Nil(err)
+
+	edges, err := g.GetEdges(5)
+	assert.Nil(err)
+	assert.Equal(0, len(edges)) // keepSelfLoops is false: no 5->5 self-loop
+
+	g2 := graph.NewGraph[int, string]()
+	g2.SetNode(1, "Node 1")
+	g2.SetNode(2, "Node 2")
+	g2.SetNode(5, "Node 5")
+	assert.Nil(g2.AddEdge(1, 5, nil))
+
+	err = g2.MergeNodes([]int{1, 2}, 5, "Merged", sumMerge, true)
+	assert.Nil(err)
+
+	edges, err = g2.GetEdges(5)
+	assert.Nil(err)
+	assert.Equal([]int{5}, edges) // keepSelfLoops is true: 5->5 self-loop kept
+}
+
+func TestContract(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "a")
+	g.SetNode(2, "a")
+	g.SetNode(3, "b")
+	assert.Nil(g.AddEdge(1, 3, nil))
+	assert.Nil(g.AddEdge(2, 3, nil))
+
+	contracted := graph.Contract(&g, func(key int, value string) string { return value })
+
+	aNode, err := contracted.GetNode("a")
+	assert.Nil(err)
+	assert.Equal(2, len(aNode))
+
+	edges, err := contracted.GetEdges("a")
+	assert.Nil(err)
+	assert.Equal([]string{"b"}, edges)
+}
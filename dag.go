@@ -0,0 +1,177 @@
+package graph
+
+import "fmt"
+
+// nodeColor tracks DFS visitation state for TopologicalSort and IsAcyclic:
+// white means unvisited, gray means on the current DFS stack, black means
+// fully processed.
+type nodeColor int
+
+const (
+	white nodeColor = iota
+	gray
+	black
+)
+
+// CycleErr is returned by TopologicalSort when the graph contains a cycle.
+// Path holds the cycle itself, starting and ending on the same key.
+type CycleErr[K comparable] struct {
+	Path []K
+}
+
+func (e *CycleErr[K]) Error() string {
+	return fmt.Sprintf("graph contains a cycle: %+v", e.Path)
+}
+
+// dfsFrame is a single stack frame of the iterative DFS used by
+// TopologicalSort: key is the node being explored and edgeIdx is the index
+// of the next outgoing edge of key left to visit.
+type dfsFrame[K comparable] struct {
+	key     K
+	edgeIdx int
+}
+
+// TopologicalSort returns the node keys ordered so that every edge A -> B
+// places A before B. It fails with a *CycleErr if the graph isn't a DAG.
+func (g *Graph[K, T]) TopologicalSort() ([]K, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	colors := map[K]nodeColor{}
+	order := make([]K, 0, len(g.nodes))
+
+	for start := range g.nodes {
+		if colors[start] != white {
+			continue
+		}
+
+		stack := []dfsFrame[K]{{key: start}}
+		colors[start] = gray
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			edges := g.edges[top.key]
+
+			if top.edgeIdx >= len(edges) {
+				colors[top.key] = black
+				order = append(order, top.key)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			next := edges[top.edgeIdx]
+			top.edgeIdx++
+
+			switch colors[next] {
+			case white:
+				colors[next] = gray
+				stack = append(stack, dfsFrame[K]{key: next})
+			case gray:
+				cycle := []K{next}
+				for i := len(stack) - 1; stack[i].key != next; i-- {
+					cycle = append(cycle, stack[i].key)
+				}
+				cycle = append(cycle, next)
+				reverseSlice(cycle)
+				return nil, &CycleErr[K]{Path: cycle}
+			}
+		}
+	}
+
+	reverseSlice(order)
+
+	return order, nil
+}
+
+// IsAcyclic reports whether the graph contains no cycles.
+func (g *Graph[K, T]) IsAcyclic() bool {
+	_, err := g.TopologicalSort()
+	return err == nil
+}
+
+// tarjanFrame is a single stack frame of the iterative Tarjan's algorithm
+// used by StronglyConnectedComponents.
+type tarjanFrame[K comparable] struct {
+	key     K
+	edgeIdx int
+}
+
+// StronglyConnectedComponents returns the strongly connected components of
+// the graph using an iterative version of Tarjan's algorithm, so it also
+// works on cyclic graphs.
+func (g *Graph[K, T]) StronglyConnectedComponents() [][]K {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	index := map[K]int{}
+	lowlink := map[K]int{}
+	onStack := map[K]bool{}
+	var sccStack []K
+	var components [][]K
+	next := 0
+
+	for start := range g.nodes {
+		if _, visited := index[start]; visited {
+			continue
+		}
+
+		callStack := []tarjanFrame[K]{{key: start}}
+		index[start] = next
+		lowlink[start] = next
+		next++
+		sccStack = append(sccStack, start)
+		onStack[start] = true
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			edges := g.edges[top.key]
+
+			if top.edgeIdx < len(edges) {
+				w := edges[top.edgeIdx]
+				top.edgeIdx++
+
+				if _, visited := index[w]; !visited {
+					index[w] = next
+					lowlink[w] = next
+					next++
+					sccStack = append(sccStack, w)
+					onStack[w] = true
+					callStack = append(callStack, tarjanFrame[K]{key: w})
+				} else if onStack[w] {
+					if index[w] < lowlink[top.key] {
+						lowlink[top.key] = index[w]
+					}
+				}
+
+				continue
+			}
+
+			v := top.key
+			callStack = callStack[:len(callStack)-1]
+
+			if len(callStack) > 0 {
+				parent := &callStack[len(callStack)-1]
+				if lowlink[v] < lowlink[parent.key] {
+					lowlink[parent.key] = lowlink[v]
+				}
+			}
+
+			if lowlink[v] == index[v] {
+				var component []K
+				for {
+					n := len(sccStack) - 1
+					w := sccStack[n]
+					sccStack = sccStack[:n]
+					onStack[w] = false
+					component = append(component, w)
+					if w == v {
+						break
+					}
+				}
+				components = append(components, component)
+			}
+		}
+	}
+
+	return components
+}
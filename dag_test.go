@@ -0,0 +1,60 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/joetifa2003/graph-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	assert.Nil(g.AddEdge(1, 2, nil))
+	assert.Nil(g.AddEdge(2, 3, nil))
+
+	order, err := g.TopologicalSort()
+	assert.Nil(err)
+	assert.Equal([]int{1, 2, 3}, order)
+	assert.True(g.IsAcyclic())
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	assert.Nil(g.AddEdge(1, 2, nil))
+	assert.Nil(g.AddEdge(2, 3, nil))
+	assert.Nil(g.AddEdge(3, 1, nil))
+
+	_, err := g.TopologicalSort()
+	assert.Error(err)
+	assert.False(g.IsAcyclic())
+
+	var cycleErr *graph.CycleErr[int]
+	assert.ErrorAs(err, &cycleErr)
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	g.SetNode(4, "Node 4")
+	assert.Nil(g.AddEdge(1, 2, nil))
+	assert.Nil(g.AddEdge(2, 3, nil))
+	assert.Nil(g.AddEdge(3, 1, nil))
+	assert.Nil(g.AddEdge(3, 4, nil))
+
+	sccs := g.StronglyConnectedComponents()
+	assert.Equal(2, len(sccs))
+}
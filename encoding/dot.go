@@ -0,0 +1,67 @@
+package encoding
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/joetifa2003/graph-go"
+)
+
+// MarshalDOT renders g as a Graphviz DOT digraph. Edge metadata is encoded
+// through enc and rendered as a label= attribute. If group is non-nil, nodes
+// are clustered into `subgraph cluster_<group>` blocks by the key it returns.
+func MarshalDOT[K comparable, T any](g *graph.Graph[K, T], enc Encoder[K, T], group func(K) string) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("digraph G {\n")
+
+	keys := g.GetNodeKeys(func(T) bool { return true })
+
+	if group != nil {
+		clusters := map[string][]K{}
+		for _, key := range keys {
+			clusterKey := group(key)
+			clusters[clusterKey] = append(clusters[clusterKey], key)
+		}
+
+		for clusterKey, clusterKeys := range clusters {
+			fmt.Fprintf(&b, "  subgraph \"cluster_%s\" {\n", clusterKey)
+			fmt.Fprintf(&b, "    label=%q;\n", clusterKey)
+			for _, key := range clusterKeys {
+				fmt.Fprintf(&b, "    %q;\n", fmt.Sprintf("%v", key))
+			}
+			b.WriteString("  }\n")
+		}
+	} else {
+		for _, key := range keys {
+			fmt.Fprintf(&b, "  %q;\n", fmt.Sprintf("%v", key))
+		}
+	}
+
+	for _, key := range keys {
+		edges, err := g.GetEdges(key)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, to := range edges {
+			label := ""
+			if meta := g.GetMetaData(key, to); meta != nil {
+				encoded, err := enc.EdgeEncode(meta)
+				if err != nil {
+					return nil, err
+				}
+				label = fmt.Sprintf("%v", encoded)
+			}
+
+			if label != "" {
+				fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", fmt.Sprintf("%v", key), fmt.Sprintf("%v", to), label)
+			} else {
+				fmt.Fprintf(&b, "  %q -> %q;\n", fmt.Sprintf("%v", key), fmt.Sprintf("%v", to))
+			}
+		}
+	}
+
+	b.WriteString("}\n")
+
+	return []byte(b.String()), nil
+}
@@ -0,0 +1,18 @@
+// Package encoding provides codecs for serializing a graph.Graph to and from
+// external formats such as JSON, Graphviz DOT, and GraphML.
+package encoding
+
+// Encoder lets callers plug in per-type marshaling for a Graph[K, T], since
+// node values and edge metadata are generic and can't be marshaled
+// generically by this package.
+type Encoder[K comparable, T any] struct {
+	NodeEncode func(T) (map[string]any, error)
+	EdgeEncode func(interface{}) (map[string]any, error)
+}
+
+// Decoder is the inverse of Encoder: it lets callers rebuild node values and
+// edge metadata from the plain maps produced by a codec's unmarshaling step.
+type Decoder[K comparable, T any] struct {
+	NodeDecode func(map[string]any) (T, error)
+	EdgeDecode func(map[string]any) (interface{}, error)
+}
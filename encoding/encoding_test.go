@@ -0,0 +1,89 @@
+package encoding_test
+
+import (
+	"testing"
+
+	"github.com/joetifa2003/graph-go"
+	"github.com/joetifa2003/graph-go/encoding"
+	"github.com/stretchr/testify/assert"
+)
+
+func identityEncoder() encoding.Encoder[string, string] {
+	return encoding.Encoder[string, string]{
+		NodeEncode: func(v string) (map[string]any, error) {
+			return map[string]any{"value": v}, nil
+		},
+		EdgeEncode: func(meta interface{}) (map[string]any, error) {
+			return map[string]any{"meta": meta}, nil
+		},
+	}
+}
+
+func identityDecoder() encoding.Decoder[string, string] {
+	return encoding.Decoder[string, string]{
+		NodeDecode: func(v map[string]any) (string, error) {
+			return v["value"].(string), nil
+		},
+		EdgeDecode: func(v map[string]any) (interface{}, error) {
+			return v["meta"], nil
+		},
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[string, string]()
+	g.SetNode("a", "Node A")
+	g.SetNode("b", "Node B")
+	assert.Nil(g.AddEdge("a", "b", "edge-meta"))
+
+	data, err := encoding.MarshalJSON(&g, identityEncoder())
+	assert.Nil(err)
+
+	roundTripped, err := encoding.UnmarshalJSON[string](data, identityDecoder())
+	assert.Nil(err)
+
+	node, err := roundTripped.GetNode("a")
+	assert.Nil(err)
+	assert.Equal("Node A", node)
+
+	edges, err := roundTripped.GetEdges("a")
+	assert.Nil(err)
+	assert.Equal([]string{"b"}, edges)
+}
+
+func TestMarshalDOT(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[string, string]()
+	g.SetNode("a", "Node A")
+	g.SetNode("b", "Node B")
+	assert.Nil(g.AddEdge("a", "b", nil))
+
+	data, err := encoding.MarshalDOT(&g, identityEncoder(), nil)
+	assert.Nil(err)
+	assert.Contains(string(data), "digraph G {")
+	assert.Contains(string(data), `"a" -> "b"`)
+}
+
+func TestUnmarshalGraphML(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := []byte(`<graphml><graph>
+		<node id="a"><data key="label">Node A</data></node>
+		<node id="b"><data key="label">Node B</data></node>
+		<edge source="a" target="b"><data key="weight">1</data></edge>
+	</graph></graphml>`)
+
+	g, err := encoding.UnmarshalGraphML(doc)
+	assert.Nil(err)
+
+	node, err := g.GetNode("a")
+	assert.Nil(err)
+	assert.Equal("Node A", node["label"])
+
+	edges, err := g.GetEdges("a")
+	assert.Nil(err)
+	assert.Equal([]string{"b"}, edges)
+}
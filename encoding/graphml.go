@@ -0,0 +1,61 @@
+package encoding
+
+import (
+	"encoding/xml"
+
+	"github.com/joetifa2003/graph-go"
+)
+
+type graphmlData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphmlNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphmlData `xml:"data"`
+}
+
+type graphmlEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphmlData `xml:"data"`
+}
+
+type graphmlGraph struct {
+	Nodes []graphmlNode `xml:"graph>node"`
+	Edges []graphmlEdge `xml:"graph>edge"`
+}
+
+// UnmarshalGraphML parses a GraphML document into a
+// Graph[string, map[string]string], where each node's value and each edge's
+// metadata are the key/value pairs of their <data> children.
+func UnmarshalGraphML(data []byte) (graph.Graph[string, map[string]string], error) {
+	g := graph.NewGraph[string, map[string]string]()
+
+	var in graphmlGraph
+	if err := xml.Unmarshal(data, &in); err != nil {
+		return g, err
+	}
+
+	for _, n := range in.Nodes {
+		g.SetNode(n.ID, dataToMap(n.Data))
+	}
+
+	for _, e := range in.Edges {
+		if err := g.AddEdge(e.Source, e.Target, dataToMap(e.Data)); err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func dataToMap(data []graphmlData) map[string]string {
+	m := map[string]string{}
+	for _, d := range data {
+		m[d.Key] = d.Value
+	}
+
+	return m
+}
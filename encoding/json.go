@@ -0,0 +1,102 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/joetifa2003/graph-go"
+)
+
+type jsonNode struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value"`
+}
+
+type jsonEdge struct {
+	From string         `json:"from"`
+	To   string         `json:"to"`
+	Meta map[string]any `json:"meta,omitempty"`
+}
+
+type jsonGraph struct {
+	Nodes []jsonNode `json:"nodes"`
+	Edges []jsonEdge `json:"edges"`
+}
+
+// MarshalJSON serializes g to JSON, encoding node values and edge metadata
+// through enc. Node keys are rendered with fmt.Sprintf("%v", key).
+func MarshalJSON[K comparable, T any](g *graph.Graph[K, T], enc Encoder[K, T]) ([]byte, error) {
+	out := jsonGraph{}
+
+	for _, key := range g.GetNodeKeys(func(T) bool { return true }) {
+		value, err := g.GetNode(key)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedValue, err := enc.NodeEncode(value)
+		if err != nil {
+			return nil, err
+		}
+		out.Nodes = append(out.Nodes, jsonNode{Key: fmt.Sprintf("%v", key), Value: encodedValue})
+
+		edges, err := g.GetEdges(key)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, to := range edges {
+			var meta map[string]any
+			if m := g.GetMetaData(key, to); m != nil {
+				meta, err = enc.EdgeEncode(m)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			out.Edges = append(out.Edges, jsonEdge{
+				From: fmt.Sprintf("%v", key),
+				To:   fmt.Sprintf("%v", to),
+				Meta: meta,
+			})
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON parses JSON produced by MarshalJSON into a Graph[string, T],
+// decoding node values and edge metadata through dec.
+func UnmarshalJSON[T any](data []byte, dec Decoder[string, T]) (graph.Graph[string, T], error) {
+	g := graph.NewGraph[string, T]()
+
+	var in jsonGraph
+	if err := json.Unmarshal(data, &in); err != nil {
+		return g, err
+	}
+
+	for _, n := range in.Nodes {
+		value, err := dec.NodeDecode(n.Value)
+		if err != nil {
+			return g, err
+		}
+		g.SetNode(n.Key, value)
+	}
+
+	for _, e := range in.Edges {
+		var meta interface{}
+		if e.Meta != nil {
+			decoded, err := dec.EdgeDecode(e.Meta)
+			if err != nil {
+				return g, err
+			}
+			meta = decoded
+		}
+
+		if err := g.AddEdge(e.From, e.To, meta); err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
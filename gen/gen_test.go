@@ -0,0 +1,51 @@
+package gen_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/joetifa2003/graph-go/gen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrid(t *testing.T) {
+	assert := assert.New(t)
+
+	g := gen.Grid([]int{2, 2})
+	edges, err := g.GetEdges(0)
+	assert.Nil(err)
+	assert.Equal(2, len(edges)) // corner cell connects to its 2 axis neighbors
+}
+
+func TestNavigableSmallWorld(t *testing.T) {
+	assert := assert.New(t)
+
+	rng := rand.New(rand.NewSource(1))
+	g := gen.NavigableSmallWorld([]int{4, 4}, 1, 2, 2, rng)
+
+	edges, err := g.GetEdges(0)
+	assert.Nil(err)
+	assert.True(len(edges) >= 2) // at least its short-range neighbors
+}
+
+func TestErdosRenyi(t *testing.T) {
+	assert := assert.New(t)
+
+	rng := rand.New(rand.NewSource(1))
+	g := gen.ErdosRenyi(10, 1, rng) // p=1 means fully connected
+
+	edges, err := g.GetEdges(0)
+	assert.Nil(err)
+	assert.Equal(9, len(edges))
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	assert := assert.New(t)
+
+	rng := rand.New(rand.NewSource(1))
+	g := gen.BarabasiAlbert(10, 2, rng)
+
+	edges, err := g.GetEdges(9)
+	assert.Nil(err)
+	assert.True(len(edges) >= 1)
+}
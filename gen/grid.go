@@ -0,0 +1,65 @@
+// Package gen provides random and structured graph generators, for use as
+// reproducible fixtures when benchmarking or prototyping against graph.Graph.
+package gen
+
+import "github.com/joetifa2003/graph-go"
+
+// Grid returns an n-dimensional lattice graph keyed by the linear index of
+// each cell (row-major over dims), with an undirected edge between every
+// pair of cells that differ by 1 along exactly one dimension.
+func Grid(dims []int) graph.Graph[int, struct{}] {
+	g := graph.NewGraph[int, struct{}]()
+
+	total := 1
+	for _, d := range dims {
+		total *= d
+	}
+
+	for i := 0; i < total; i++ {
+		g.SetNode(i, struct{}{})
+	}
+
+	coords := make([]int, len(dims))
+	for i := 0; i < total; i++ {
+		indexToCoords(i, dims, coords)
+
+		for d := range dims {
+			if coords[d]+1 < dims[d] {
+				neighbor := append([]int{}, coords...)
+				neighbor[d]++
+				g.AddUndirectedEdge(i, coordsToIndex(neighbor, dims), nil)
+			}
+		}
+	}
+
+	return g
+}
+
+func indexToCoords(i int, dims []int, coords []int) {
+	for d := len(dims) - 1; d >= 0; d-- {
+		coords[d] = i % dims[d]
+		i /= dims[d]
+	}
+}
+
+func coordsToIndex(coords []int, dims []int) int {
+	index := 0
+	for d := 0; d < len(dims); d++ {
+		index = index*dims[d] + coords[d]
+	}
+
+	return index
+}
+
+func l1Distance(a, b []int) int {
+	d := 0
+	for i := range a {
+		diff := a[i] - b[i]
+		if diff < 0 {
+			diff = -diff
+		}
+		d += diff
+	}
+
+	return d
+}
@@ -0,0 +1,76 @@
+package gen
+
+import (
+	"math/rand"
+
+	"github.com/joetifa2003/graph-go"
+)
+
+// ErdosRenyi returns a G(n, p) random graph: n nodes, with an undirected
+// edge between each pair sampled independently with probability p.
+func ErdosRenyi(n int, p float64, rng *rand.Rand) graph.Graph[int, struct{}] {
+	g := graph.NewGraph[int, struct{}]()
+
+	for i := 0; i < n; i++ {
+		g.SetNode(i, struct{}{})
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if rng.Float64() < p {
+				g.AddUndirectedEdge(i, j, nil)
+			}
+		}
+	}
+
+	return g
+}
+
+// BarabasiAlbert returns a scale-free graph grown by preferential
+// attachment: starting from a small connected core of m nodes, each
+// remaining node connects to m existing nodes chosen with probability
+// proportional to their current degree.
+func BarabasiAlbert(n, m int, rng *rand.Rand) graph.Graph[int, struct{}] {
+	g := graph.NewGraph[int, struct{}]()
+
+	for i := 0; i < n; i++ {
+		g.SetNode(i, struct{}{})
+	}
+
+	if n == 0 {
+		return g
+	}
+
+	core := m
+	if core > n {
+		core = n
+	}
+
+	targets := make([]int, 0, n*m*2)
+	for i := 0; i < core; i++ {
+		for j := i + 1; j < core; j++ {
+			g.AddUndirectedEdge(i, j, nil)
+			targets = append(targets, i, j)
+		}
+	}
+
+	for i := core; i < n; i++ {
+		chosen := map[int]bool{}
+		for len(chosen) < m && len(chosen) < i {
+			var candidate int
+			if len(targets) == 0 {
+				candidate = rng.Intn(i)
+			} else {
+				candidate = targets[rng.Intn(len(targets))]
+			}
+			chosen[candidate] = true
+		}
+
+		for target := range chosen {
+			g.AddUndirectedEdge(i, target, nil)
+			targets = append(targets, i, target)
+		}
+	}
+
+	return g
+}
@@ -0,0 +1,84 @@
+package gen
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/joetifa2003/graph-go"
+)
+
+// NavigableSmallWorld builds a Kleinberg navigable small-world graph on an
+// n-dimensional lattice of shape dims: every node is connected to every
+// other node within L1 distance p (short-range links), plus q long-range
+// shortcuts per node sampled with probability proportional to d(u,v)^-r.
+// Self-loops and duplicate edges are skipped.
+func NavigableSmallWorld(dims []int, p, q int, r float64, rng *rand.Rand) graph.Graph[int, struct{}] {
+	g := graph.NewGraph[int, struct{}]()
+
+	total := 1
+	for _, d := range dims {
+		total *= d
+	}
+
+	coordsOf := make([][]int, total)
+	coords := make([]int, len(dims))
+	for i := 0; i < total; i++ {
+		g.SetNode(i, struct{}{})
+		indexToCoords(i, dims, coords)
+		coordsOf[i] = append([]int{}, coords...)
+	}
+
+	for u := 0; u < total; u++ {
+		for v := u + 1; v < total; v++ {
+			if l1Distance(coordsOf[u], coordsOf[v]) <= p {
+				g.AddUndirectedEdge(u, v, nil)
+			}
+		}
+	}
+
+	for u := 0; u < total; u++ {
+		weights := make([]float64, total)
+		sum := 0.0
+
+		for v := 0; v < total; v++ {
+			if v == u || l1Distance(coordsOf[u], coordsOf[v]) <= p {
+				continue
+			}
+
+			w := math.Pow(float64(l1Distance(coordsOf[u], coordsOf[v])), -r)
+			weights[v] = w
+			sum += w
+		}
+
+		for shortcut := 0; shortcut < q && sum > 0; shortcut++ {
+			target := weightedChoice(weights, sum, rng)
+			if target < 0 {
+				break
+			}
+
+			g.AddUndirectedEdge(u, target, nil)
+			sum -= weights[target]
+			weights[target] = 0
+		}
+	}
+
+	return g
+}
+
+func weightedChoice(weights []float64, sum float64, rng *rand.Rand) int {
+	target := rng.Float64() * sum
+	acc := 0.0
+
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+
+		acc += w
+		if target <= acc {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/gammazero/deque"
 )
@@ -15,28 +16,53 @@ func (e *NodeNotFoundErr[K]) Error() string {
 }
 
 // Graph contains all the nodes and edges
-// where K is the key type and T is the node type
+// where K is the key type and T is the node type.
+//
+// Graph is handled by value throughout this package (NewGraph returns a
+// Graph, not a *Graph), so its lock is held behind a pointer: copying a
+// Graph (e.g. `g2 := g`) copies the pointer, not the mutex, meaning g and g2
+// alias the same lock and the same underlying maps. That's what makes
+// concurrent use of the two copies safe; it also means such a copy is NOT
+// an independent graph. Use Snapshot to get a truly independent deep copy.
 type Graph[K comparable, T any] struct {
+	mu            *sync.RWMutex
 	nodes         map[K]T
 	edges         map[K][]K
 	edgesMetaData map[K]map[K]interface{}
+	weights       map[K]map[K]float64
 }
 
 func NewGraph[K comparable, T any]() Graph[K, T] {
 	return Graph[K, T]{
+		mu:            &sync.RWMutex{},
 		nodes:         map[K]T{},
 		edges:         map[K][]K{},
 		edgesMetaData: map[K]map[K]interface{}{},
+		weights:       map[K]map[K]float64{},
 	}
 }
 
 // SetNode sets a node with a value T to the key K
 func (g *Graph[K, T]) SetNode(key K, value T) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.setNode(key, value)
+}
+
+func (g *Graph[K, T]) setNode(key K, value T) {
 	g.nodes[key] = value
 }
 
 // GetEdges gets edges of node with key K
 func (g *Graph[K, T]) GetEdges(key K) ([]K, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.getEdges(key)
+}
+
+func (g *Graph[K, T]) getEdges(key K) ([]K, error) {
 	_, exists := g.nodes[key]
 	if !exists {
 		return nil, &NodeNotFoundErr[K]{Key: key}
@@ -48,7 +74,14 @@ func (g *Graph[K, T]) GetEdges(key K) ([]K, error) {
 // AddEdge adds a directed edge between A and B (A -> B)
 // If A already have B edge it will do nothing
 func (g *Graph[K, T]) AddEdge(keyA K, keyB K, metadata interface{}) error {
-	nodeAEdges, err := g.GetEdges(keyA)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.addEdge(keyA, keyB, metadata)
+}
+
+func (g *Graph[K, T]) addEdge(keyA K, keyB K, metadata interface{}) error {
+	nodeAEdges, err := g.getEdges(keyA)
 	if err != nil {
 		return err
 	}
@@ -57,7 +90,7 @@ func (g *Graph[K, T]) AddEdge(keyA K, keyB K, metadata interface{}) error {
 		nodeAEdges = append(nodeAEdges, keyB)
 		g.edges[keyA] = nodeAEdges
 		if metadata != nil {
-			g.SetMetaData(keyA, keyB, metadata)
+			g.setMetaData(keyA, keyB, metadata)
 		}
 	}
 
@@ -65,6 +98,13 @@ func (g *Graph[K, T]) AddEdge(keyA K, keyB K, metadata interface{}) error {
 }
 
 func (g *Graph[K, T]) SetMetaData(keyA K, keyB K, metadata interface{}) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.setMetaData(keyA, keyB, metadata)
+}
+
+func (g *Graph[K, T]) setMetaData(keyA K, keyB K, metadata interface{}) {
 	if g.edgesMetaData[keyA] == nil {
 		g.edgesMetaData[keyA] = map[K]interface{}{}
 	}
@@ -73,29 +113,36 @@ func (g *Graph[K, T]) SetMetaData(keyA K, keyB K, metadata interface{}) {
 }
 
 func (g *Graph[K, T]) SetUndirectedMetaData(keyA K, keyB K, metadata interface{}) {
-	g.SetMetaData(keyA, keyB, metadata)
-	g.SetMetaData(keyB, keyA, metadata)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.setMetaData(keyA, keyB, metadata)
+	g.setMetaData(keyB, keyA, metadata)
 }
 
 // AddUndirectedEdge adds an undirected edge between A and B (A <-> B)
 // If A already have B edge or B already have A edge it will do nothing
 func (g *Graph[K, T]) AddUndirectedEdge(keyA K, keyB K, metadata interface{}) error {
-	err := g.AddEdge(keyA, keyB, metadata)
-	if err != nil {
-		return err
-	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-	err = g.AddEdge(keyB, keyA, metadata)
-	if err != nil {
+	if err := g.addEdge(keyA, keyB, metadata); err != nil {
 		return err
 	}
 
-	return nil
+	return g.addEdge(keyB, keyA, metadata)
 }
 
 // RemoveEdge removes an edge (another node with a key K) from the node with a key K
 func (g *Graph[K, T]) RemoveEdge(key K, edge K) error {
-	nodeEdges, err := g.GetEdges(key)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.removeEdge(key, edge)
+}
+
+func (g *Graph[K, T]) removeEdge(key K, edge K) error {
+	nodeEdges, err := g.getEdges(key)
 	if err != nil {
 		return err
 	}
@@ -104,6 +151,7 @@ func (g *Graph[K, T]) RemoveEdge(key K, edge K) error {
 		if e == edge {
 			nodeEdges = removeIndex(nodeEdges, i)
 			g.edges[key] = nodeEdges
+			delete(g.weights[key], edge)
 			return nil
 		}
 	}
@@ -114,12 +162,22 @@ func (g *Graph[K, T]) RemoveEdge(key K, edge K) error {
 }
 
 func (g *Graph[K, T]) RemoveUndirectedEdge(keyA K, keyB K) {
-	g.RemoveEdge(keyA, keyB)
-	g.RemoveEdge(keyB, keyA)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.removeEdge(keyA, keyB)
+	g.removeEdge(keyB, keyA)
 }
 
 // GetNode gets node T from key K
 func (g *Graph[K, T]) GetNode(key K) (node T, err error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.getNode(key)
+}
+
+func (g *Graph[K, T]) getNode(key K) (node T, err error) {
 	node, ok := g.nodes[key]
 	if ok {
 		return node, nil
@@ -130,6 +188,13 @@ func (g *Graph[K, T]) GetNode(key K) (node T, err error) {
 
 // Gets the meta data between A -> B edge
 func (g *Graph[K, T]) GetMetaData(keyA K, keyB K) interface{} {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.getMetaData(keyA, keyB)
+}
+
+func (g *Graph[K, T]) getMetaData(keyA K, keyB K) interface{} {
 	metadata, ok := g.edgesMetaData[keyA][keyB]
 	if ok {
 		return metadata
@@ -145,11 +210,14 @@ type path[K comparable] struct {
 
 // Get shortest path between two node keys using breadth first search
 func (g *Graph[K, T]) ShortestPath(start K, end K) ([]T, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	queue := deque.New[path[K]]()
 	queue.PushBack(path[K]{nodeKey: start, prev: nil})
 
 	// Check if end node exist
-	_, err := g.GetNode(end)
+	_, err := g.getNode(end)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +235,7 @@ func (g *Graph[K, T]) ShortestPath(start K, end K) ([]T, error) {
 			return g.pathToArrayOfNodes(p), nil
 		}
 
-		nodeEdges, err := g.GetEdges(p.nodeKey)
+		nodeEdges, err := g.getEdges(p.nodeKey)
 		if err != nil {
 			return nil, err
 		}
@@ -183,6 +251,9 @@ func (g *Graph[K, T]) ShortestPath(start K, end K) ([]T, error) {
 // GetNodeKey invokes f on each node in the graph and returns a key as soon as f returns true.
 // if f never returned true, returns (zeroValue for K), false
 func (g *Graph[K, T]) GetNodeKey(f func(T) bool) (key K, ok bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	for key, value := range g.nodes {
 		if f(value) {
 			return key, true
@@ -196,6 +267,9 @@ func (g *Graph[K, T]) GetNodeKey(f func(T) bool) (key K, ok bool) {
 // GetNodeKey invokes f on each node in the graph, if f returns true the current node key K
 // that f is visiting will be added to the keys returned
 func (g *Graph[K, T]) GetNodeKeys(f func(T) bool) (keys []K) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	keys = []K{}
 
 	for key, value := range g.nodes {
@@ -210,11 +284,11 @@ func (g *Graph[K, T]) GetNodeKeys(f func(T) bool) (keys []K) {
 func (g *Graph[K, T]) pathToArrayOfNodes(p path[K]) []T {
 	nodes := []T{}
 	for p.prev != nil {
-		node, _ := g.GetNode(p.nodeKey)
+		node, _ := g.getNode(p.nodeKey)
 		nodes = append(nodes, node)
 		p = *p.prev
 	}
-	node, _ := g.GetNode(p.nodeKey)
+	node, _ := g.getNode(p.nodeKey)
 	nodes = append(nodes, node) // last node
 
 	// Reverse nodes
@@ -0,0 +1,124 @@
+package graph
+
+import "container/heap"
+
+// dijkstraItem is an entry in the priority queue used by DijkstraShortestPath
+// and AStarShortestPath, ordered by priority (tentative distance + heuristic).
+type dijkstraItem[K comparable] struct {
+	key      K
+	priority float64
+	index    int
+}
+
+type dijkstraQueue[K comparable] []*dijkstraItem[K]
+
+func (q dijkstraQueue[K]) Len() int { return len(q) }
+
+func (q dijkstraQueue[K]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+
+func (q dijkstraQueue[K]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *dijkstraQueue[K]) Push(x interface{}) {
+	item := x.(*dijkstraItem[K])
+	item.index = len(*q)
+	*q = append(*q, item)
+}
+
+func (q *dijkstraQueue[K]) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*q = old[:n-1]
+
+	return item
+}
+
+// DijkstraShortestPath finds the lowest-cost path between start and end using
+// edge weights set via AddWeightedEdge (edges without a weight default to 1),
+// returning the node sequence and its total cost. It is equivalent to
+// AStarShortestPath with a heuristic that always returns 0.
+func (g *Graph[K, T]) DijkstraShortestPath(start K, end K) ([]T, float64, error) {
+	return g.AStarShortestPath(start, end, func(K) float64 { return 0 })
+}
+
+// AStarShortestPath finds the lowest-cost path between start and end using
+// edge weights set via AddWeightedEdge, guided by heuristic. heuristic is
+// called with a node key and must return an estimate of the remaining cost
+// to end; passing a heuristic that always returns 0 reduces the search to
+// plain Dijkstra.
+func (g *Graph[K, T]) AStarShortestPath(start K, end K, heuristic func(K) float64) ([]T, float64, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if _, err := g.getNode(start); err != nil {
+		return nil, 0, err
+	}
+	if _, err := g.getNode(end); err != nil {
+		return nil, 0, err
+	}
+
+	dist := map[K]float64{start: 0}
+	cameFrom := map[K]K{}
+	closed := map[K]bool{}
+
+	pq := dijkstraQueue[K]{}
+	heap.Push(&pq, &dijkstraItem[K]{key: start, priority: heuristic(start)})
+
+	for pq.Len() != 0 {
+		current := heap.Pop(&pq).(*dijkstraItem[K]).key
+		if closed[current] {
+			continue
+		}
+		closed[current] = true
+
+		if current == end {
+			return g.reconstructPath(cameFrom, end), dist[end], nil
+		}
+
+		edges, err := g.getEdges(current)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, next := range edges {
+			if closed[next] {
+				continue
+			}
+
+			tentative := dist[current] + g.getWeight(current, next)
+			if d, ok := dist[next]; !ok || tentative < d {
+				dist[next] = tentative
+				cameFrom[next] = current
+				heap.Push(&pq, &dijkstraItem[K]{key: next, priority: tentative + heuristic(next)})
+			}
+		}
+	}
+
+	return []T{}, 0, nil
+}
+
+func (g *Graph[K, T]) reconstructPath(cameFrom map[K]K, end K) []T {
+	keys := []K{end}
+	for {
+		prev, ok := cameFrom[keys[len(keys)-1]]
+		if !ok {
+			break
+		}
+		keys = append(keys, prev)
+	}
+	reverseSlice(keys)
+
+	nodes := make([]T, 0, len(keys))
+	for _, k := range keys {
+		node, _ := g.getNode(k)
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
@@ -0,0 +1,80 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/joetifa2003/graph-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDijkstraShortestPath(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	g.SetNode(4, "Node 4")
+
+	assert.Nil(g.AddWeightedEdge(1, 2, 1))
+	assert.Nil(g.AddWeightedEdge(1, 3, 4))
+	assert.Nil(g.AddWeightedEdge(2, 3, 1))
+	assert.Nil(g.AddWeightedEdge(3, 4, 1))
+
+	path, cost, err := g.DijkstraShortestPath(1, 4)
+	assert.Nil(err)
+	assert.Equal([]string{"Node 1", "Node 2", "Node 3", "Node 4"}, path)
+	assert.Equal(float64(3), cost)
+
+	_, _, err = g.DijkstraShortestPath(69, 145) // non existing nodes
+	assert.Error(err)
+}
+
+func TestWeightDoesNotShareStorageWithMetaData(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+
+	assert.Nil(g.AddWeightedEdge(1, 2, 3.5))
+	g.SetMetaData(1, 2, "unrelated metadata")
+
+	assert.Equal(3.5, g.GetWeight(1, 2))
+	assert.Equal("unrelated metadata", g.GetMetaData(1, 2))
+}
+
+func TestRemoveEdgeClearsWeight(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+
+	assert.Nil(g.AddWeightedEdge(1, 2, 9.5))
+	assert.Nil(g.RemoveEdge(1, 2))
+	assert.Nil(g.AddEdge(1, 2, nil)) // fresh, unweighted re-add
+
+	assert.Equal(1.0, g.GetWeight(1, 2)) // must fall back to the default, not the stale 9.5
+}
+
+func TestAStarShortestPath(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	g.SetNode(4, "Node 4")
+
+	assert.Nil(g.AddWeightedEdge(1, 2, 1))
+	assert.Nil(g.AddWeightedEdge(1, 3, 4))
+	assert.Nil(g.AddWeightedEdge(2, 3, 1))
+	assert.Nil(g.AddWeightedEdge(3, 4, 1))
+
+	// zero heuristic should behave exactly like Dijkstra
+	path, cost, err := g.AStarShortestPath(1, 4, func(int) float64 { return 0 })
+	assert.Nil(err)
+	assert.Equal([]string{"Node 1", "Node 2", "Node 3", "Node 4"}, path)
+	assert.Equal(float64(3), cost)
+}
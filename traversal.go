@@ -0,0 +1,157 @@
+package graph
+
+// BFS walks a Graph[K, T] in breadth-first order.
+type BFS[K comparable, T any] struct {
+	g *Graph[K, T]
+}
+
+// NewBFS returns a BFS traversal over g.
+func NewBFS[K comparable, T any](g *Graph[K, T]) BFS[K, T] {
+	return BFS[K, T]{g: g}
+}
+
+// Walk visits every node reachable from start in breadth-first order,
+// calling visit on each one. It stops as soon as visit returns true. visit
+// must not call back into the graph it is walking.
+func (b BFS[K, T]) Walk(start K, visit func(K) bool) {
+	b.g.mu.RLock()
+	defer b.g.mu.RUnlock()
+
+	visited := map[K]bool{start: true}
+	queue := []K{start}
+
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+
+		if visit(key) {
+			return
+		}
+
+		for _, edge := range b.g.edges[key] {
+			if !visited[edge] {
+				visited[edge] = true
+				queue = append(queue, edge)
+			}
+		}
+	}
+}
+
+// DFS walks a Graph[K, T] in depth-first order.
+type DFS[K comparable, T any] struct {
+	g *Graph[K, T]
+}
+
+// NewDFS returns a DFS traversal over g.
+func NewDFS[K comparable, T any](g *Graph[K, T]) DFS[K, T] {
+	return DFS[K, T]{g: g}
+}
+
+// Walk visits every node reachable from start in depth-first order, calling
+// visit on each one. It stops as soon as visit returns true. visit must not
+// call back into the graph it is walking.
+func (d DFS[K, T]) Walk(start K, visit func(K) bool) {
+	d.g.mu.RLock()
+	defer d.g.mu.RUnlock()
+
+	dfsWalk(d.g.edges, start, map[K]bool{}, visit)
+}
+
+// dfsWalk is the lock-free depth-first walk over the adjacency map edges,
+// shared by DFS.Walk and WalkAll, so WalkAll can thread a single visited set
+// across every component without re-acquiring the graph's lock per component.
+func dfsWalk[K comparable](edges map[K][]K, start K, visited map[K]bool, visit func(K) bool) {
+	stack := []K{start}
+
+	for len(stack) > 0 {
+		n := len(stack) - 1
+		key := stack[n]
+		stack = stack[:n]
+
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		if visit(key) {
+			return
+		}
+
+		for _, edge := range edges[key] {
+			if !visited[edge] {
+				stack = append(stack, edge)
+			}
+		}
+	}
+}
+
+// WalkAll visits every connected component of the graph in depth-first
+// order. before is called when a new component starts, during is called for
+// every node visited, and after is called once the component is exhausted.
+// Edges are followed in both directions, so a component is found whole no
+// matter which of its nodes it is entered from.
+func (g *Graph[K, T]) WalkAll(before func(), during func(K), after func()) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	neighbors := make(map[K][]K, len(g.nodes))
+	for from, edges := range g.edges {
+		for _, to := range edges {
+			neighbors[from] = append(neighbors[from], to)
+			neighbors[to] = append(neighbors[to], from)
+		}
+	}
+
+	visited := map[K]bool{}
+
+	for key := range g.nodes {
+		if visited[key] {
+			continue
+		}
+
+		before()
+		dfsWalk(neighbors, key, visited, func(k K) bool {
+			during(k)
+			return false
+		})
+		after()
+	}
+}
+
+// GraphVisitEdges walks every edge of the graph in postorder depth-first
+// order, calling edge with the metadata attached to it. It stops and returns
+// the first error edge returns.
+func (g *Graph[K, T]) GraphVisitEdges(edge func(from, to K, meta interface{}) error) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	visited := map[K]bool{}
+
+	var visit func(key K) error
+	visit = func(key K) error {
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+
+		for _, next := range g.edges[key] {
+			if err := visit(next); err != nil {
+				return err
+			}
+
+			if err := edge(key, next, g.getMetaData(key, next)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for key := range g.nodes {
+		if err := visit(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
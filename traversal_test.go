@@ -0,0 +1,80 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/joetifa2003/graph-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildLineGraph() graph.Graph[int, string] {
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3")
+	g.AddEdge(1, 2, nil)
+	g.AddEdge(2, 3, nil)
+
+	return g
+}
+
+func TestBFSWalk(t *testing.T) {
+	assert := assert.New(t)
+
+	g := buildLineGraph()
+	var visited []int
+	graph.NewBFS(&g).Walk(1, func(k int) bool {
+		visited = append(visited, k)
+		return false
+	})
+
+	assert.Equal([]int{1, 2, 3}, visited)
+}
+
+func TestDFSWalkStopsEarly(t *testing.T) {
+	assert := assert.New(t)
+
+	g := buildLineGraph()
+	var visited []int
+	graph.NewDFS(&g).Walk(1, func(k int) bool {
+		visited = append(visited, k)
+		return k == 2
+	})
+
+	assert.Equal([]int{1, 2}, visited)
+}
+
+func TestWalkAll(t *testing.T) {
+	assert := assert.New(t)
+
+	g := graph.NewGraph[int, string]()
+	g.SetNode(1, "Node 1")
+	g.SetNode(2, "Node 2")
+	g.SetNode(3, "Node 3") // disconnected
+	g.AddEdge(1, 2, nil)
+
+	components := 0
+	var visited []int
+	g.WalkAll(
+		func() { components++ },
+		func(k int) { visited = append(visited, k) },
+		func() {},
+	)
+
+	assert.Equal(2, components)
+	assert.Equal(3, len(visited))
+}
+
+func TestGraphVisitEdges(t *testing.T) {
+	assert := assert.New(t)
+
+	g := buildLineGraph()
+	var edges [][2]int
+	err := g.GraphVisitEdges(func(from, to int, meta interface{}) error {
+		edges = append(edges, [2]int{from, to})
+		return nil
+	})
+
+	assert.Nil(err)
+	assert.Equal(2, len(edges))
+}
@@ -0,0 +1,43 @@
+package graph
+
+// AddWeightedEdge adds a directed edge between A and B (A -> B) carrying a
+// numeric weight, for use by DijkstraShortestPath and AStarShortestPath.
+// The weight is kept in its own storage, separate from the edge metadata set
+// by SetMetaData, so the two don't clobber each other.
+func (g *Graph[K, T]) AddWeightedEdge(keyA K, keyB K, weight float64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.addEdge(keyA, keyB, nil); err != nil {
+		return err
+	}
+
+	g.setWeight(keyA, keyB, weight)
+
+	return nil
+}
+
+func (g *Graph[K, T]) setWeight(keyA K, keyB K, weight float64) {
+	if g.weights[keyA] == nil {
+		g.weights[keyA] = map[K]float64{}
+	}
+
+	g.weights[keyA][keyB] = weight
+}
+
+// GetWeight returns the weight of the A -> B edge as set by AddWeightedEdge,
+// defaulting to 1 when the edge has no weight attached.
+func (g *Graph[K, T]) GetWeight(keyA K, keyB K) float64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.getWeight(keyA, keyB)
+}
+
+func (g *Graph[K, T]) getWeight(keyA K, keyB K) float64 {
+	if w, ok := g.weights[keyA][keyB]; ok {
+		return w
+	}
+
+	return 1
+}